@@ -0,0 +1,82 @@
+package geocode
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGeocodeCallQueryWithExtraComputations(t *testing.T) {
+	service := NewService(nil, "test-key")
+	call := service.Geocode("1600 Amphitheatre Parkway")
+	call.ExtraComputations = []string{"ADDRESS_DESCRIPTORS", "BUILDING_AND_ENTRANCES"}
+
+	got := call.query()
+	want := "address=1600+Amphitheatre+Parkway&extra_computations=ADDRESS_DESCRIPTORS%7CBUILDING_AND_ENTRANCES&key=test-key"
+	if got != want {
+		t.Errorf("query() = %q, want %q", got, want)
+	}
+}
+
+func TestGeocodeResponseUnmarshalAddressDescriptor(t *testing.T) {
+	body := `{
+		"status": "OK",
+		"results": [{"formatted_address": "1600 Amphitheatre Parkway"}],
+		"address_descriptor": {
+			"landmarks": [{
+				"place_id": "landmark-1",
+				"display_name": "Googleplex",
+				"types": ["point_of_interest"],
+				"straight_line_distance_meters": 12.5,
+				"travel_distance_meters": 20.1,
+				"spatial_relationship": "NEAR"
+			}],
+			"areas": [{
+				"place_id": "area-1",
+				"display_name": "Mountain View",
+				"containment_type": "WITHIN"
+			}]
+		}
+	}`
+
+	var resp GeocodeResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("failed to unmarshal fixture: %v", err)
+	}
+
+	want := &AddressDescriptor{
+		Landmarks: []Landmark{{
+			PlaceID:                    "landmark-1",
+			DisplayName:                "Googleplex",
+			Types:                      []string{"point_of_interest"},
+			StraightLineDistanceMeters: 12.5,
+			TravelDistanceMeters:       20.1,
+			SpatialRelationship:        "NEAR",
+		}},
+		Areas: []Area{{
+			PlaceID:         "area-1",
+			DisplayName:     "Mountain View",
+			ContainmentType: "WITHIN",
+		}},
+	}
+
+	if !reflect.DeepEqual(resp.AddressDescriptor, want) {
+		t.Errorf("AddressDescriptor = %+v, want %+v", resp.AddressDescriptor, want)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].FormattedAddress != "1600 Amphitheatre Parkway" {
+		t.Errorf("Results = %+v, want the single geocode result alongside the descriptor", resp.Results)
+	}
+}
+
+func TestGeocodeResponseUnmarshalWithoutAddressDescriptor(t *testing.T) {
+	body := `{"status": "OK", "results": [{"formatted_address": "1600 Amphitheatre Parkway"}]}`
+
+	var resp GeocodeResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("failed to unmarshal fixture: %v", err)
+	}
+
+	if resp.AddressDescriptor != nil {
+		t.Errorf("AddressDescriptor = %+v, want nil when extra_computations was not requested", resp.AddressDescriptor)
+	}
+}
@@ -1,20 +1,121 @@
 package geocode
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 )
 
 var (
 	errLatLngOrPlaceIdRequire = errors.New("Lat,Lng or PlaceId is required")
 
+	errAddressOrComponentsRequire = errors.New("Address or Components is required")
 )
 
+// Geocode is the process of converting a human-readable address into geographic coordinates
+func (p *Service) Geocode(address string) *GeocodeCall {
+	return &GeocodeCall{
+		service: p,
+		address: address,
+	}
+}
+
+type GeocodeCall struct {
+	service *Service
+
+	// The street address or plus code that you want to geocode.
+	address string
+
+	// A component filter for which you wish to obtain a geocode, e.g. {"country": "US", "postal_code": "94043"}
+	Components map[string]string
+
+	// The bounding box of the viewport within which to bias geocode results more prominently
+	Bounds *LatLngArea
+
+	// The region code, specified as a ccTLD two-character value, used to bias the results
+	Region string
+
+	// The language code, indicating in which language the results should be returned, if possible.
+	Language string
+
+	// Additional computations to perform on top of the regular geocode response, e.g. "ADDRESS_DESCRIPTORS"
+	ExtraComputations []string
+}
+
+func (n *GeocodeCall) validate() error {
+	if n.address != "" {
+		return nil
+	}
+	if len(n.Components) > 0 {
+		return nil
+	}
+
+	return errAddressOrComponentsRequire
+}
+
+// Do sends the request using context.Background(). See DoContext.
+func (n *GeocodeCall) Do() (*GeocodeResponse, error) {
+	return n.DoContext(context.Background())
+}
+
+// DoContext sends the request, observing cancellation and deadlines from ctx.
+func (n *GeocodeCall) DoContext(ctx context.Context) (*GeocodeResponse, error) {
+	if err := n.validate(); err != nil {
+		return nil, err
+	}
+
+	return n.service.doRequest(ctx, "/json", n.query())
+}
+
+func (n *GeocodeCall) query() string {
+	query := make(url.Values)
+	n.service.addAuth(query)
+
+	if n.address != "" {
+		query.Add("address", n.address)
+	}
+	if len(n.Components) > 0 {
+		query.Add("components", encodeComponents(n.Components))
+	}
+	if n.Bounds != nil {
+		query.Add("bounds", encodeLatLngArea(*n.Bounds))
+	}
+	if n.Region != "" {
+		query.Add("region", n.Region)
+	}
+	if n.Language != "" {
+		query.Add("language", n.Language)
+	}
+	if len(n.ExtraComputations) > 0 {
+		query.Add("extra_computations", strings.Join(n.ExtraComputations, "|"))
+	}
+
+	return query.Encode()
+}
+
+// encodeComponents serializes a component filter as e.g. "country:US|postal_code:94043"
+func encodeComponents(components map[string]string) string {
+	keys := make([]string, 0, len(components))
+	for k := range components {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+":"+components[k])
+	}
+
+	return strings.Join(pairs, "|")
+}
+
+// encodeLatLngArea serializes a bounding box as "sw.lat,sw.lng|ne.lat,ne.lng"
+func encodeLatLngArea(area LatLngArea) string {
+	return fmt.Sprintf("%f,%f|%f,%f", area.SoutWest.Lat, area.SoutWest.Lng, area.NorthEase.Lat, area.NorthEase.Lng)
+}
 
 // ReverseGeocode is the process of converting geographic coordinates into a human-readable address
 func (p *Service) ReverseGeocode(lat, lng float64) *ReverseGeocodeCall {
@@ -41,6 +142,9 @@ type ReverseGeocodeCall struct {
 	ResultType []string
 	// One or more location types, Specifying a type will restrict the results to this type
 	LocationType []string
+
+	// Additional computations to perform on top of the regular geocode response, e.g. "ADDRESS_DESCRIPTORS"
+	ExtraComputations []string
 }
 
 func (n *ReverseGeocodeCall) validate() error {
@@ -55,50 +159,29 @@ func (n *ReverseGeocodeCall) validate() error {
 	return errLatLngOrPlaceIdRequire
 }
 
+// Do sends the request using context.Background(). See DoContext.
 func (n *ReverseGeocodeCall) Do() (*GeocodeResponse, error) {
-	if err := n.validate(); err != nil {
-		return nil, err
-	}
-
-	searchURL := baseURL + "/json?" + n.query()
-
-	resp, err := n.service.client.Get(searchURL)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("bad resp %d: %s", resp.StatusCode, body)
-	}
+	return n.DoContext(context.Background())
+}
 
-	data := &GeocodeResponse{}
-	if err := json.Unmarshal(body, data); err != nil {
+// DoContext sends the request, observing cancellation and deadlines from ctx.
+func (n *ReverseGeocodeCall) DoContext(ctx context.Context) (*GeocodeResponse, error) {
+	if err := n.validate(); err != nil {
 		return nil, err
 	}
 
-	if data.Status != "OK" {
-		return nil, &apiError{
-			Status:  data.Status,
-			Message: data.ErrorMessage,
-		}
-	}
-
-	return data, nil
+	return n.service.doRequest(ctx, "/json", n.query())
 }
 
 func (r *ReverseGeocodeCall) query() string {
 	query := make(url.Values)
-	query.Add("key", r.service.key)
+	r.service.addAuth(query)
 
 	if r.lat != 0 || r.lng != 0 {
 		query.Add("latlng", fmt.Sprintf("%f,%f", r.lat, r.lng))
 	}
 	if r.PlaceId != "" {
-		query.Add("place_id", r.Language)
+		query.Add("place_id", r.PlaceId)
 	}
 	if r.Language != "" {
 		query.Add("language", r.Language)
@@ -119,6 +202,9 @@ func (r *ReverseGeocodeCall) query() string {
 	if len(locationTypes) > 0 {
 		query.Add("location_type", strings.Join(locationTypes, "|"))
 	}
+	if len(r.ExtraComputations) > 0 {
+		query.Add("extra_computations", strings.Join(r.ExtraComputations, "|"))
+	}
 
 	return query.Encode()
 }
@@ -132,6 +218,42 @@ type GeocodeResponse struct {
 	ErrorMessage string `json:"error_message,omitempty"`
 	// A set of attributions about this listing which must be displayed to the user.
 	HTMLAttributions []string `json:"html_attributions"`
+	// AddressDescriptor is only populated when extra_computations=ADDRESS_DESCRIPTORS was requested
+	AddressDescriptor *AddressDescriptor `json:"address_descriptor,omitempty"`
+}
+
+// AddressDescriptor relates a location to specific landmarks and precise containing areas
+type AddressDescriptor struct {
+	// Landmarks near the target location, ordered by ranking score
+	Landmarks []Landmark `json:"landmarks"`
+	// Areas that contain the target location, ordered from most to least granular
+	Areas []Area `json:"areas"`
+}
+
+// Landmark is a nearby place that can be used to describe the target location
+type Landmark struct {
+	// A textual identifier that uniquely identifies the landmark place.
+	PlaceID string `json:"place_id"`
+	// The display name of the landmark.
+	DisplayName string `json:"display_name"`
+	// The type(s) of the landmark.
+	Types []string `json:"types"`
+	// The straight line distance, in meters, between the target location and the landmark.
+	StraightLineDistanceMeters float64 `json:"straight_line_distance_meters"`
+	// The travel distance, in meters, between the target location and the landmark.
+	TravelDistanceMeters float64 `json:"travel_distance_meters"`
+	// The spatial relationship between the target location and the landmark, e.g. "NEAR", "WITHIN"
+	SpatialRelationship string `json:"spatial_relationship"`
+}
+
+// Area is a containing area of the target location, such as a neighborhood
+type Area struct {
+	// A textual identifier that uniquely identifies the area place.
+	PlaceID string `json:"place_id"`
+	// The display name of the area.
+	DisplayName string `json:"display_name"`
+	// How the target location is contained within the area, e.g. "WITHIN", "OUTSKIRTS"
+	ContainmentType string `json:"containment_type"`
 }
 
 // An AddressComponent is a component used to compose a given address
@@ -0,0 +1,91 @@
+package geocode
+
+import "testing"
+
+func TestEncodeComponents(t *testing.T) {
+	tests := []struct {
+		name       string
+		components map[string]string
+		want       string
+	}{
+		{
+			name:       "sorts keys for a stable encoding",
+			components: map[string]string{"postal_code": "94043", "country": "US"},
+			want:       "country:US|postal_code:94043",
+		},
+		{
+			name:       "single component",
+			components: map[string]string{"country": "US"},
+			want:       "country:US",
+		},
+		{
+			name:       "empty map",
+			components: map[string]string{},
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encodeComponents(tt.components); got != tt.want {
+				t.Errorf("encodeComponents(%v) = %q, want %q", tt.components, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeLatLngArea(t *testing.T) {
+	area := LatLngArea{
+		SoutWest:  LatLng{Lat: 37.0, Lng: -122.1},
+		NorthEase: LatLng{Lat: 37.5, Lng: -122.0},
+	}
+
+	want := "37.000000,-122.100000|37.500000,-122.000000"
+	if got := encodeLatLngArea(area); got != want {
+		t.Errorf("encodeLatLngArea(%+v) = %q, want %q", area, got, want)
+	}
+}
+
+func TestGeocodeCallValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		call    GeocodeCall
+		wantErr error
+	}{
+		{
+			name: "address set",
+			call: GeocodeCall{address: "1600 Amphitheatre Parkway"},
+		},
+		{
+			name: "components set",
+			call: GeocodeCall{Components: map[string]string{"country": "US"}},
+		},
+		{
+			name:    "neither set",
+			call:    GeocodeCall{},
+			wantErr: errAddressOrComponentsRequire,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call.validate(); err != tt.wantErr {
+				t.Errorf("validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeocodeCallQuery(t *testing.T) {
+	service := NewService(nil, "test-key")
+	call := service.Geocode("1600 Amphitheatre Parkway")
+	call.Components = map[string]string{"country": "US"}
+	call.Region = "us"
+	call.Language = "en"
+
+	got := call.query()
+	want := "address=1600+Amphitheatre+Parkway&components=country%3AUS&key=test-key&language=en&region=us"
+	if got != want {
+		t.Errorf("query() = %q, want %q", got, want)
+	}
+}
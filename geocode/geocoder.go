@@ -0,0 +1,134 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+)
+
+var errNoBackends = errors.New("no geocoder backends configured")
+
+var errAddressRequire = errors.New("Address is required")
+
+// GeocodeRequest describes a forward geocoding query, independent of backend.
+type GeocodeRequest struct {
+	Address    string
+	Components map[string]string
+	Bounds     *LatLngArea
+	Region     string
+	Language   string
+}
+
+// ReverseGeocodeRequest describes a reverse geocoding query, independent of backend.
+type ReverseGeocodeRequest struct {
+	Lat, Lng float64
+	PlaceId  string
+	Language string
+}
+
+// Geocoder is implemented by every geocoding backend supported by this package,
+// normalizing each provider's native response into GeocodeDetail.
+type Geocoder interface {
+	Geocode(ctx context.Context, req GeocodeRequest) ([]GeocodeDetail, error)
+	ReverseGeocode(ctx context.Context, req ReverseGeocodeRequest) ([]GeocodeDetail, error)
+}
+
+// GoogleGeocoder adapts Service to the Geocoder interface.
+type GoogleGeocoder struct {
+	service *Service
+}
+
+// NewGoogleGeocoder creates a Geocoder backed by the Google Geocoding API.
+func NewGoogleGeocoder(service *Service) *GoogleGeocoder {
+	return &GoogleGeocoder{service: service}
+}
+
+func (g *GoogleGeocoder) Geocode(ctx context.Context, req GeocodeRequest) ([]GeocodeDetail, error) {
+	call := g.service.Geocode(req.Address)
+	call.Components = req.Components
+	call.Bounds = req.Bounds
+	call.Region = req.Region
+	call.Language = req.Language
+
+	resp, err := call.DoContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
+
+func (g *GoogleGeocoder) ReverseGeocode(ctx context.Context, req ReverseGeocodeRequest) ([]GeocodeDetail, error) {
+	call := g.service.ReverseGeocode(req.Lat, req.Lng)
+	call.PlaceId = req.PlaceId
+	call.Language = req.Language
+
+	resp, err := call.DoContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
+
+// MultiGeocoder tries each backend in order, falling through to the next backend on error or on
+// a successful-but-empty result, and returning the first non-empty result.
+type MultiGeocoder struct {
+	backends []Geocoder
+}
+
+// NewMultiGeocoder creates a MultiGeocoder that tries backends in the given order.
+func NewMultiGeocoder(backends ...Geocoder) *MultiGeocoder {
+	return &MultiGeocoder{backends: backends}
+}
+
+func (m *MultiGeocoder) Geocode(ctx context.Context, req GeocodeRequest) ([]GeocodeDetail, error) {
+	if len(m.backends) == 0 {
+		return nil, errNoBackends
+	}
+
+	var lastErr error
+	var lastDetails []GeocodeDetail
+	for _, backend := range m.backends {
+		details, err := backend.Geocode(ctx, req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(details) > 0 {
+			return details, nil
+		}
+		lastErr, lastDetails = nil, details
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+
+	return lastDetails, nil
+}
+
+func (m *MultiGeocoder) ReverseGeocode(ctx context.Context, req ReverseGeocodeRequest) ([]GeocodeDetail, error) {
+	if len(m.backends) == 0 {
+		return nil, errNoBackends
+	}
+
+	var lastErr error
+	var lastDetails []GeocodeDetail
+	for _, backend := range m.backends {
+		details, err := backend.ReverseGeocode(ctx, req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(details) > 0 {
+			return details, nil
+		}
+		lastErr, lastDetails = nil, details
+	}
+
+	if lastErr != nil {
+		return nil, lastErr
+	}
+
+	return lastDetails, nil
+}
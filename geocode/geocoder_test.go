@@ -0,0 +1,161 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// stubGeocoder is a Geocoder test double returning canned details/errors and counting calls.
+type stubGeocoder struct {
+	details []GeocodeDetail
+	err     error
+	called  int
+}
+
+func (s *stubGeocoder) Geocode(ctx context.Context, req GeocodeRequest) ([]GeocodeDetail, error) {
+	s.called++
+	return s.details, s.err
+}
+
+func (s *stubGeocoder) ReverseGeocode(ctx context.Context, req ReverseGeocodeRequest) ([]GeocodeDetail, error) {
+	s.called++
+	return s.details, s.err
+}
+
+func TestMultiGeocoderFallsThroughOnEmptyResult(t *testing.T) {
+	empty := &stubGeocoder{details: []GeocodeDetail{}}
+	found := &stubGeocoder{details: []GeocodeDetail{{FormattedAddress: "found"}}}
+
+	m := NewMultiGeocoder(empty, found)
+
+	details, err := m.Geocode(context.Background(), GeocodeRequest{Address: "somewhere"})
+	if err != nil {
+		t.Fatalf("Geocode() unexpected error: %v", err)
+	}
+	if empty.called != 1 || found.called != 1 {
+		t.Fatalf("expected both backends to be tried, got empty.called=%d found.called=%d", empty.called, found.called)
+	}
+	if len(details) != 1 || details[0].FormattedAddress != "found" {
+		t.Fatalf("Geocode() = %+v, want the second backend's result", details)
+	}
+}
+
+func TestMultiGeocoderFallsThroughOnError(t *testing.T) {
+	failing := &stubGeocoder{err: errors.New("rate limited")}
+	found := &stubGeocoder{details: []GeocodeDetail{{FormattedAddress: "found"}}}
+
+	m := NewMultiGeocoder(failing, found)
+
+	details, err := m.ReverseGeocode(context.Background(), ReverseGeocodeRequest{Lat: 1, Lng: 2})
+	if err != nil {
+		t.Fatalf("ReverseGeocode() unexpected error: %v", err)
+	}
+	if failing.called != 1 || found.called != 1 {
+		t.Fatalf("expected both backends to be tried, got failing.called=%d found.called=%d", failing.called, found.called)
+	}
+	if len(details) != 1 || details[0].FormattedAddress != "found" {
+		t.Fatalf("ReverseGeocode() = %+v, want the second backend's result", details)
+	}
+}
+
+func TestMultiGeocoderStopsAtFirstSuccess(t *testing.T) {
+	first := &stubGeocoder{details: []GeocodeDetail{{FormattedAddress: "first"}}}
+	second := &stubGeocoder{details: []GeocodeDetail{{FormattedAddress: "second"}}}
+
+	m := NewMultiGeocoder(first, second)
+
+	details, err := m.Geocode(context.Background(), GeocodeRequest{Address: "somewhere"})
+	if err != nil {
+		t.Fatalf("Geocode() unexpected error: %v", err)
+	}
+	if second.called != 0 {
+		t.Errorf("second backend should not have been called, called=%d", second.called)
+	}
+	if len(details) != 1 || details[0].FormattedAddress != "first" {
+		t.Fatalf("Geocode() = %+v, want the first backend's result", details)
+	}
+}
+
+func TestMultiGeocoderAllEmptyReturnsEmptyResult(t *testing.T) {
+	m := NewMultiGeocoder(&stubGeocoder{details: []GeocodeDetail{}}, &stubGeocoder{details: []GeocodeDetail{}})
+
+	details, err := m.Geocode(context.Background(), GeocodeRequest{Address: "nowhere"})
+	if err != nil {
+		t.Fatalf("Geocode() unexpected error: %v", err)
+	}
+	if len(details) != 0 {
+		t.Errorf("Geocode() = %+v, want empty result", details)
+	}
+}
+
+func TestMultiGeocoderAllErrorsReturnsLastError(t *testing.T) {
+	wantErr := errors.New("second backend down")
+	m := NewMultiGeocoder(&stubGeocoder{err: errors.New("first backend down")}, &stubGeocoder{err: wantErr})
+
+	_, err := m.Geocode(context.Background(), GeocodeRequest{Address: "nowhere"})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Geocode() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestMultiGeocoderNoBackends(t *testing.T) {
+	m := NewMultiGeocoder()
+
+	if _, err := m.Geocode(context.Background(), GeocodeRequest{Address: "x"}); err != errNoBackends {
+		t.Errorf("Geocode() error = %v, want errNoBackends", err)
+	}
+	if _, err := m.ReverseGeocode(context.Background(), ReverseGeocodeRequest{}); err != errNoBackends {
+		t.Errorf("ReverseGeocode() error = %v, want errNoBackends", err)
+	}
+}
+
+func TestGoogleGeocoderGeocode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("address"); got != "1600 Amphitheatre Parkway" {
+			t.Errorf("unexpected address query param: %q", got)
+		}
+		w.Write([]byte(`{"status":"OK","results":[{"formatted_address":"1600 Amphitheatre Parkway, Mountain View, CA"}]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(http.DefaultClient, "test-key")
+	service.SetURL(server.URL)
+
+	g := NewGoogleGeocoder(service)
+	details, err := g.Geocode(context.Background(), GeocodeRequest{Address: "1600 Amphitheatre Parkway"})
+	if err != nil {
+		t.Fatalf("Geocode() unexpected error: %v", err)
+	}
+	if len(details) != 1 || details[0].FormattedAddress != "1600 Amphitheatre Parkway, Mountain View, CA" {
+		t.Fatalf("Geocode() = %+v, want one result with the formatted address", details)
+	}
+}
+
+func TestGoogleGeocoderReverseGeocode(t *testing.T) {
+	lat, lng := 37.422476, -122.084250
+	wantLatLng := fmt.Sprintf("%f,%f", lat, lng)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("latlng"); got != wantLatLng {
+			t.Errorf("unexpected latlng query param: got %q, want %q", got, wantLatLng)
+		}
+		w.Write([]byte(`{"status":"OK","results":[{"formatted_address":"Googleplex"}]}`))
+	}))
+	defer server.Close()
+
+	service := NewService(http.DefaultClient, "test-key")
+	service.SetURL(server.URL)
+
+	g := NewGoogleGeocoder(service)
+	details, err := g.ReverseGeocode(context.Background(), ReverseGeocodeRequest{Lat: lat, Lng: lng})
+	if err != nil {
+		t.Fatalf("ReverseGeocode() unexpected error: %v", err)
+	}
+	if len(details) != 1 || details[0].FormattedAddress != "Googleplex" {
+		t.Fatalf("ReverseGeocode() = %+v, want one result with the formatted address", details)
+	}
+}
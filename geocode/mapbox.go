@@ -0,0 +1,151 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const mapboxBaseURL = "https://api.mapbox.com"
+
+// MapboxGeocoder is a Geocoder backed by the Mapbox Geocoding API.
+type MapboxGeocoder struct {
+	client      *http.Client
+	accessToken string
+	url         string
+}
+
+// NewMapboxGeocoder creates a Geocoder backed by the Mapbox Geocoding API, authenticated with accessToken.
+func NewMapboxGeocoder(client *http.Client, accessToken string) *MapboxGeocoder {
+	return &MapboxGeocoder{
+		client:      client,
+		accessToken: accessToken,
+		url:         mapboxBaseURL,
+	}
+}
+
+// SetURL allows overwriting the base url
+func (m *MapboxGeocoder) SetURL(url string) {
+	m.url = url
+}
+
+func (m *MapboxGeocoder) Geocode(ctx context.Context, req GeocodeRequest) ([]GeocodeDetail, error) {
+	if req.Address == "" {
+		return nil, errAddressRequire
+	}
+
+	return m.do(ctx, req.Address, req.Language)
+}
+
+func (m *MapboxGeocoder) ReverseGeocode(ctx context.Context, req ReverseGeocodeRequest) ([]GeocodeDetail, error) {
+	return m.do(ctx, fmt.Sprintf("%f,%f", req.Lng, req.Lat), req.Language)
+}
+
+func (m *MapboxGeocoder) do(ctx context.Context, query, language string) ([]GeocodeDetail, error) {
+	values := make(url.Values)
+	values.Set("access_token", m.accessToken)
+	if language != "" {
+		values.Set("language", language)
+	}
+
+	searchURL := m.url + "/geocoding/v5/mapbox.places/" + url.PathEscape(query) + ".json?" + values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad resp %d: %s", resp.StatusCode, body)
+	}
+
+	data := &mapboxFeatureCollection{}
+	if err := json.Unmarshal(body, data); err != nil {
+		return nil, err
+	}
+
+	details := make([]GeocodeDetail, 0, len(data.Features))
+	for _, feature := range data.Features {
+		details = append(details, feature.toGeocodeDetail())
+	}
+
+	return details, nil
+}
+
+// mapboxFeatureCollection is the GeoJSON FeatureCollection returned by the Mapbox Geocoding API.
+type mapboxFeatureCollection struct {
+	Features []mapboxFeature `json:"features"`
+}
+
+type mapboxFeature struct {
+	PlaceName string          `json:"place_name"`
+	PlaceType []string        `json:"place_type"`
+	Center    [2]float64      `json:"center"` // [lon, lat]
+	Context   []mapboxContext `json:"context"`
+}
+
+type mapboxContext struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+func (f mapboxFeature) toGeocodeDetail() GeocodeDetail {
+	var lat, lng float64
+	if f.Center != [2]float64{} {
+		lng, lat = f.Center[0], f.Center[1]
+	}
+
+	components := make([]AddressComponent, 0, len(f.Context))
+	for _, c := range f.Context {
+		kind := strings.SplitN(c.ID, ".", 2)[0]
+		components = append(components, AddressComponent{
+			Types:     []string{mapboxContextType(kind)},
+			LongName:  c.Text,
+			ShortName: c.Text,
+		})
+	}
+
+	types := make([]FeatureType, 0, len(f.PlaceType))
+	for _, t := range f.PlaceType {
+		types = append(types, FeatureType(t))
+	}
+
+	return GeocodeDetail{
+		Types:             types,
+		FormattedAddress:  f.PlaceName,
+		AddressComponents: components,
+		Geometry: Geometry{
+			Location: LatLng{Lat: lat, Lng: lng},
+		},
+	}
+}
+
+// mapboxContextType maps a Mapbox context id prefix (e.g. "postcode" in "postcode.123") to the
+// closest equivalent Google address component type, so callers can rely on one type vocabulary.
+func mapboxContextType(kind string) string {
+	switch kind {
+	case "postcode":
+		return "postal_code"
+	case "place":
+		return "locality"
+	case "region":
+		return "administrative_area_level_1"
+	case "country":
+		return "country"
+	default:
+		return kind
+	}
+}
@@ -0,0 +1,90 @@
+package geocode
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMapboxFeatureToGeocodeDetail(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want GeocodeDetail
+	}{
+		{
+			name: "full feature with context",
+			body: `{
+				"place_name": "1600 Amphitheatre Parkway, Mountain View, California 94043, United States",
+				"place_type": ["address"],
+				"center": [-122.0842499, 37.4224764],
+				"context": [
+					{"id": "postcode.123", "text": "94043"},
+					{"id": "place.456", "text": "Mountain View"},
+					{"id": "region.789", "text": "California"},
+					{"id": "country.012", "text": "United States"}
+				]
+			}`,
+			want: GeocodeDetail{
+				Types:            []FeatureType{"address"},
+				FormattedAddress: "1600 Amphitheatre Parkway, Mountain View, California 94043, United States",
+				AddressComponents: []AddressComponent{
+					{Types: []string{"postal_code"}, LongName: "94043", ShortName: "94043"},
+					{Types: []string{"locality"}, LongName: "Mountain View", ShortName: "Mountain View"},
+					{Types: []string{"administrative_area_level_1"}, LongName: "California", ShortName: "California"},
+					{Types: []string{"country"}, LongName: "United States", ShortName: "United States"},
+				},
+				Geometry: Geometry{
+					Location: LatLng{Lat: 37.4224764, Lng: -122.0842499},
+				},
+			},
+		},
+		{
+			name: "no context",
+			body: `{"place_name": "Somewhere", "place_type": ["place"], "center": [1, 2]}`,
+			want: GeocodeDetail{
+				Types:             []FeatureType{"place"},
+				FormattedAddress:  "Somewhere",
+				AddressComponents: []AddressComponent{},
+				Geometry: Geometry{
+					Location: LatLng{Lat: 2, Lng: 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var feature mapboxFeature
+			if err := json.Unmarshal([]byte(tt.body), &feature); err != nil {
+				t.Fatalf("failed to unmarshal fixture: %v", err)
+			}
+
+			got := feature.toGeocodeDetail()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toGeocodeDetail() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapboxContextType(t *testing.T) {
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{"postcode", "postal_code"},
+		{"place", "locality"},
+		{"region", "administrative_area_level_1"},
+		{"country", "country"},
+		{"neighborhood", "neighborhood"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			if got := mapboxContextType(tt.kind); got != tt.want {
+				t.Errorf("mapboxContextType(%q) = %q, want %q", tt.kind, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const photonBaseURL = "https://photon.komoot.io"
+
+// PhotonGeocoder is a Geocoder backed by Photon, an open-source geocoder built on OpenStreetMap data.
+// It requires no API key.
+type PhotonGeocoder struct {
+	client *http.Client
+	url    string
+}
+
+// NewPhotonGeocoder creates a Geocoder backed by a Photon instance, defaulting to the public photon.komoot.io server.
+func NewPhotonGeocoder(client *http.Client) *PhotonGeocoder {
+	return &PhotonGeocoder{
+		client: client,
+		url:    photonBaseURL,
+	}
+}
+
+// SetURL allows overwriting the base url, e.g. to point at a self-hosted Photon instance.
+func (p *PhotonGeocoder) SetURL(url string) {
+	p.url = url
+}
+
+func (p *PhotonGeocoder) Geocode(ctx context.Context, req GeocodeRequest) ([]GeocodeDetail, error) {
+	if req.Address == "" {
+		return nil, errAddressRequire
+	}
+
+	query := make(url.Values)
+	query.Set("q", req.Address)
+	if req.Language != "" {
+		query.Set("lang", req.Language)
+	}
+
+	return p.do(ctx, p.url+"/api?"+query.Encode())
+}
+
+func (p *PhotonGeocoder) ReverseGeocode(ctx context.Context, req ReverseGeocodeRequest) ([]GeocodeDetail, error) {
+	query := make(url.Values)
+	query.Set("lat", fmt.Sprintf("%f", req.Lat))
+	query.Set("lon", fmt.Sprintf("%f", req.Lng))
+	if req.Language != "" {
+		query.Set("lang", req.Language)
+	}
+
+	return p.do(ctx, p.url+"/reverse?"+query.Encode())
+}
+
+func (p *PhotonGeocoder) do(ctx context.Context, searchURL string) ([]GeocodeDetail, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad resp %d: %s", resp.StatusCode, body)
+	}
+
+	data := &photonFeatureCollection{}
+	if err := json.Unmarshal(body, data); err != nil {
+		return nil, err
+	}
+
+	details := make([]GeocodeDetail, 0, len(data.Features))
+	for _, feature := range data.Features {
+		details = append(details, feature.toGeocodeDetail())
+	}
+
+	return details, nil
+}
+
+// photonFeatureCollection is the GeoJSON FeatureCollection returned by the Photon API.
+type photonFeatureCollection struct {
+	Features []photonFeature `json:"features"`
+}
+
+type photonFeature struct {
+	Geometry   photonGeometry   `json:"geometry"`
+	Properties photonProperties `json:"properties"`
+}
+
+// photonGeometry holds GeoJSON point coordinates, ordered [lon, lat].
+type photonGeometry struct {
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+type photonProperties struct {
+	Name        string `json:"name"`
+	HouseNumber string `json:"housenumber"`
+	Street      string `json:"street"`
+	Postcode    string `json:"postcode"`
+	City        string `json:"city"`
+	State       string `json:"state"`
+	Country     string `json:"country"`
+	CountryCode string `json:"countrycode"`
+	OSMKey      string `json:"osm_key"`
+	OSMValue    string `json:"osm_value"`
+}
+
+func (f photonFeature) toGeocodeDetail() GeocodeDetail {
+	p := f.Properties
+
+	var components []AddressComponent
+	if p.HouseNumber != "" {
+		components = append(components, AddressComponent{Types: []string{"street_number"}, LongName: p.HouseNumber, ShortName: p.HouseNumber})
+	}
+	if p.Street != "" {
+		components = append(components, AddressComponent{Types: []string{"route"}, LongName: p.Street, ShortName: p.Street})
+	}
+	if p.City != "" {
+		components = append(components, AddressComponent{Types: []string{"locality"}, LongName: p.City, ShortName: p.City})
+	}
+	if p.State != "" {
+		components = append(components, AddressComponent{Types: []string{"administrative_area_level_1"}, LongName: p.State, ShortName: p.State})
+	}
+	if p.Postcode != "" {
+		components = append(components, AddressComponent{Types: []string{"postal_code"}, LongName: p.Postcode, ShortName: p.Postcode})
+	}
+	if p.Country != "" {
+		components = append(components, AddressComponent{Types: []string{"country"}, LongName: p.Country, ShortName: p.CountryCode})
+	}
+
+	var lat, lng float64
+	if f.Geometry.Coordinates != [2]float64{} {
+		lng, lat = f.Geometry.Coordinates[0], f.Geometry.Coordinates[1]
+	}
+
+	var types []FeatureType
+	if p.OSMValue != "" {
+		types = []FeatureType{FeatureType(p.OSMValue)}
+	}
+
+	return GeocodeDetail{
+		Types:             types,
+		FormattedAddress:  photonFormattedAddress(p),
+		AddressComponents: components,
+		Geometry: Geometry{
+			Location: LatLng{Lat: lat, Lng: lng},
+		},
+	}
+}
+
+func photonFormattedAddress(p photonProperties) string {
+	var parts []string
+	switch {
+	case p.Street != "" && p.HouseNumber != "":
+		parts = append(parts, p.Street+" "+p.HouseNumber)
+	case p.Street != "":
+		parts = append(parts, p.Street)
+	case p.Name != "":
+		parts = append(parts, p.Name)
+	}
+	if p.City != "" {
+		parts = append(parts, p.City)
+	}
+	if p.State != "" {
+		parts = append(parts, p.State)
+	}
+	if p.Country != "" {
+		parts = append(parts, p.Country)
+	}
+
+	return strings.Join(parts, ", ")
+}
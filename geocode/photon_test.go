@@ -0,0 +1,108 @@
+package geocode
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestPhotonFeatureToGeocodeDetail(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want GeocodeDetail
+	}{
+		{
+			name: "full address",
+			body: `{
+				"geometry": {"coordinates": [-122.0842499, 37.4224764]},
+				"properties": {
+					"name": "Googleplex",
+					"housenumber": "1600",
+					"street": "Amphitheatre Parkway",
+					"postcode": "94043",
+					"city": "Mountain View",
+					"state": "California",
+					"country": "United States",
+					"countrycode": "US",
+					"osm_key": "office",
+					"osm_value": "company"
+				}
+			}`,
+			want: GeocodeDetail{
+				Types: []FeatureType{"company"},
+				AddressComponents: []AddressComponent{
+					{Types: []string{"street_number"}, LongName: "1600", ShortName: "1600"},
+					{Types: []string{"route"}, LongName: "Amphitheatre Parkway", ShortName: "Amphitheatre Parkway"},
+					{Types: []string{"locality"}, LongName: "Mountain View", ShortName: "Mountain View"},
+					{Types: []string{"administrative_area_level_1"}, LongName: "California", ShortName: "California"},
+					{Types: []string{"postal_code"}, LongName: "94043", ShortName: "94043"},
+					{Types: []string{"country"}, LongName: "United States", ShortName: "US"},
+				},
+				FormattedAddress: "Amphitheatre Parkway 1600, Mountain View, California, United States",
+				Geometry: Geometry{
+					Location: LatLng{Lat: 37.4224764, Lng: -122.0842499},
+				},
+			},
+		},
+		{
+			name: "no street, falls back to name",
+			body: `{
+				"geometry": {"coordinates": [2.3522, 48.8566]},
+				"properties": {"name": "Eiffel Tower", "city": "Paris", "country": "France"}
+			}`,
+			want: GeocodeDetail{
+				AddressComponents: []AddressComponent{
+					{Types: []string{"locality"}, LongName: "Paris", ShortName: "Paris"},
+					{Types: []string{"country"}, LongName: "France", ShortName: ""},
+				},
+				FormattedAddress: "Eiffel Tower, Paris, France",
+				Geometry: Geometry{
+					Location: LatLng{Lat: 48.8566, Lng: 2.3522},
+				},
+			},
+		},
+		{
+			name: "empty properties",
+			body: `{"geometry": {"coordinates": [0, 0]}, "properties": {}}`,
+			want: GeocodeDetail{
+				Geometry: Geometry{Location: LatLng{Lat: 0, Lng: 0}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var feature photonFeature
+			if err := json.Unmarshal([]byte(tt.body), &feature); err != nil {
+				t.Fatalf("failed to unmarshal fixture: %v", err)
+			}
+
+			got := feature.toGeocodeDetail()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toGeocodeDetail() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPhotonFeatureCollectionUnmarshal(t *testing.T) {
+	body := `{
+		"features": [
+			{"geometry": {"coordinates": [1, 2]}, "properties": {"name": "A"}},
+			{"geometry": {"coordinates": [3, 4]}, "properties": {"name": "B"}}
+		]
+	}`
+
+	var collection photonFeatureCollection
+	if err := json.Unmarshal([]byte(body), &collection); err != nil {
+		t.Fatalf("failed to unmarshal fixture: %v", err)
+	}
+
+	if len(collection.Features) != 2 {
+		t.Fatalf("got %d features, want 2", len(collection.Features))
+	}
+	if collection.Features[0].Properties.Name != "A" || collection.Features[1].Properties.Name != "B" {
+		t.Errorf("features decoded out of order: %+v", collection.Features)
+	}
+}
@@ -0,0 +1,156 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RetryPolicy controls how Service retries requests that fail with a transient error.
+type RetryPolicy struct {
+	// MaxRetries is the number of additional attempts made after the initial request.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry; each subsequent retry doubles it.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay.
+	MaxDelay time.Duration
+}
+
+// defaultRetryPolicy is used by NewService unless overridden with WithRetryPolicy.
+var defaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  500 * time.Millisecond,
+	MaxDelay:   8 * time.Second,
+}
+
+// Option configures optional Service behavior.
+type Option func(*Service)
+
+// WithQPS limits all requests made through the Service to n per second.
+func WithQPS(n int) Option {
+	return func(s *Service) {
+		s.limiter = rate.NewLimiter(rate.Limit(n), n)
+	}
+}
+
+// WithRetryPolicy overrides the default retry policy used when Google responds with a transient error.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(s *Service) {
+		s.retry = policy
+	}
+}
+
+// backoff returns the delay before the given retry attempt (0-indexed), with jitter, capped at MaxDelay.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << uint(attempt)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+func isRetryableStatus(status string) bool {
+	switch status {
+	case "OVER_QUERY_LIMIT", "UNKNOWN_ERROR":
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableHTTPStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// apiError is returned when Google responds 200 OK with a non-"OK" status, e.g. ZERO_RESULTS
+// or REQUEST_DENIED.
+type apiError struct {
+	Status  string
+	Message string
+}
+
+func (e *apiError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s: %s", e.Status, e.Message)
+	}
+	return e.Status
+}
+
+// doRequest executes a GET against path (e.g. "/json") with encodedQuery, applying the Service's
+// rate limit, retry policy, and request signing, and decodes the result into a GeocodeResponse.
+func (s *Service) doRequest(ctx context.Context, path, encodedQuery string) (*GeocodeResponse, error) {
+	searchURL, err := s.signedSearchURL(path, encodedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+
+	for attempt := 0; attempt <= s.retry.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(s.retry.backoff(attempt - 1)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		if s.limiter != nil {
+			if err := s.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		data, retryable, err := s.doOnce(ctx, searchURL)
+		if err == nil {
+			return data, nil
+		}
+		lastErr = err
+		if !retryable {
+			return nil, err
+		}
+	}
+
+	return nil, lastErr
+}
+
+func (s *Service) doOnce(ctx context.Context, searchURL string) (*GeocodeResponse, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, isRetryableHTTPStatus(resp.StatusCode), fmt.Errorf("bad resp %d: %s", resp.StatusCode, body)
+	}
+
+	data := &GeocodeResponse{}
+	if err := json.Unmarshal(body, data); err != nil {
+		return nil, false, err
+	}
+
+	if data.Status != "OK" {
+		return nil, isRetryableStatus(data.Status), &apiError{
+			Status:  data.Status,
+			Message: data.ErrorMessage,
+		}
+	}
+
+	return data, false, nil
+}
@@ -0,0 +1,95 @@
+package geocode
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{"OVER_QUERY_LIMIT", true},
+		{"UNKNOWN_ERROR", true},
+		{"OK", false},
+		{"ZERO_RESULTS", false},
+		{"REQUEST_DENIED", false},
+		{"INVALID_REQUEST", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			if got := isRetryableStatus(tt.status); got != tt.want {
+				t.Errorf("isRetryableStatus(%q) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryableHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code int
+		want bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.code), func(t *testing.T) {
+			if got := isRetryableHTTPStatus(tt.code); got != tt.want {
+				t.Errorf("isRetryableHTTPStatus(%d) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoffCapsAtMaxDelay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   1 * time.Second,
+	}
+
+	// After enough attempts the doubled BaseDelay overflows past MaxDelay, and backoff must fall
+	// back to MaxDelay rather than a runaway or negative duration.
+	for attempt := 0; attempt < 10; attempt++ {
+		got := policy.backoff(attempt)
+		if got < 0 || got > policy.MaxDelay {
+			t.Errorf("backoff(%d) = %v, want between 0 and MaxDelay %v", attempt, got, policy.MaxDelay)
+		}
+	}
+}
+
+func TestApiErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  apiError
+		want string
+	}{
+		{
+			name: "with message",
+			err:  apiError{Status: "REQUEST_DENIED", Message: "bad key"},
+			want: "REQUEST_DENIED: bad key",
+		},
+		{
+			name: "status only",
+			err:  apiError{Status: "ZERO_RESULTS"},
+			want: "ZERO_RESULTS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 // Package geocode provides a client for the Google Geocoding API
 package geocode
 
-import "net/http"
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
 
 const baseURL = "https://maps.googleapis.com/maps/api/geocode"
 
@@ -9,15 +13,33 @@ type Service struct {
 	client *http.Client
 	key    string
 	url    string
+
+	// limiter, if set via WithQPS, throttles all outgoing requests made through this Service.
+	limiter *rate.Limiter
+	// retry controls how transient failures (OVER_QUERY_LIMIT, 429, 5xx) are retried.
+	retry RetryPolicy
+
+	// clientID and signingKey authenticate a Premium Plan / Maps for Work account in place of key.
+	// Set via NewServiceWithClientID.
+	clientID      string
+	signingKey    []byte
+	signingKeyErr error
 }
 
 // NewService creates a new geocode service with the given http client and Google Geocoding API key
-func NewService(client *http.Client, key string) *Service {
-	return &Service{
+func NewService(client *http.Client, key string, opts ...Option) *Service {
+	s := &Service{
 		client: client,
 		key:    key,
 		url:    baseURL,
+		retry:  defaultRetryPolicy,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // SetURL allows overwriting the base url
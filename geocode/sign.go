@@ -0,0 +1,53 @@
+package geocode
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+)
+
+// NewServiceWithClientID creates a geocode service for Google Maps Premium Plan / Maps for Work
+// accounts, which authenticate requests with a client ID and an HMAC-SHA1 signature instead of a
+// plain API key. cryptoKey is the base64 web-safe signing key provided for the client ID.
+func NewServiceWithClientID(client *http.Client, clientID, cryptoKey string) *Service {
+	signingKey, err := base64.URLEncoding.DecodeString(cryptoKey)
+
+	return &Service{
+		client:        client,
+		url:           baseURL,
+		retry:         defaultRetryPolicy,
+		clientID:      clientID,
+		signingKey:    signingKey,
+		signingKeyErr: err,
+	}
+}
+
+// addAuth adds the consumer API key, or for Premium Plan client IDs the client parameter, to query.
+func (s *Service) addAuth(query url.Values) {
+	if s.clientID != "" {
+		query.Set("client", s.clientID)
+		return
+	}
+	query.Set("key", s.key)
+}
+
+// signedSearchURL builds the request URL for path (e.g. "/json"). For Premium Plan client IDs it
+// appends an HMAC-SHA1 signature, computed over "/maps/api/geocode"+path+"?"+encodedQuery with the
+// base64 web-safe decoded signing key, as a base64 web-safe encoded "signature" parameter.
+func (s *Service) signedSearchURL(path, encodedQuery string) (string, error) {
+	if s.clientID == "" {
+		return s.url + path + "?" + encodedQuery, nil
+	}
+	if s.signingKeyErr != nil {
+		return "", s.signingKeyErr
+	}
+
+	toSign := "/maps/api/geocode" + path + "?" + encodedQuery
+	mac := hmac.New(sha1.New, s.signingKey)
+	mac.Write([]byte(toSign))
+	signature := base64.URLEncoding.EncodeToString(mac.Sum(nil))
+
+	return s.url + path + "?" + encodedQuery + "&signature=" + signature, nil
+}
@@ -0,0 +1,94 @@
+package geocode
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSignedSearchURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		clientID  string
+		cryptoKey string
+		path      string
+		query     string
+		wantErr   bool
+		wantURL   string
+	}{
+		{
+			// From Google's own URL signing documentation: the reference (privateKey, path) pair
+			// and its published signature.
+			name:      "google reference signing example",
+			clientID:  "clientID",
+			cryptoKey: "vNIXE0xscrmjlyV-12Nj_BvUPaw=",
+			path:      "/json",
+			query:     "address=New+York&client=clientID",
+			wantURL:   baseURL + "/json?address=New+York&client=clientID&signature=chaRF2hTJKOScPr-RQCEhZbSzIE=",
+		},
+		{
+			name:      "invalid crypto key surfaces a decode error",
+			clientID:  "clientID",
+			cryptoKey: "not-valid-base64!!",
+			path:      "/json",
+			query:     "address=New+York&client=clientID",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServiceWithClientID(http.DefaultClient, tt.clientID, tt.cryptoKey)
+
+			got, err := s.signedSearchURL(tt.path, tt.query)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("signedSearchURL() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("signedSearchURL() unexpected error: %v", err)
+			}
+			if got != tt.wantURL {
+				t.Errorf("signedSearchURL() = %q, want %q", got, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestSignedSearchURLUsesServiceURL(t *testing.T) {
+	s := NewServiceWithClientID(http.DefaultClient, "clientID", "vNIXE0xscrmjlyV-12Nj_BvUPaw=")
+	s.SetURL("https://example.test/geocode")
+
+	got, err := s.signedSearchURL("/json", "address=New+York&client=clientID")
+	if err != nil {
+		t.Fatalf("signedSearchURL() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(got, "https://example.test/geocode/json?") {
+		t.Errorf("signedSearchURL() = %q, want it to honor SetURL", got)
+	}
+}
+
+func TestAddAuth(t *testing.T) {
+	apiKeyService := NewService(http.DefaultClient, "api-key")
+	query := make(url.Values)
+	apiKeyService.addAuth(query)
+	if got := query.Get("key"); got != "api-key" {
+		t.Errorf("addAuth() key = %q, want %q", got, "api-key")
+	}
+	if query.Get("client") != "" {
+		t.Errorf("addAuth() unexpectedly set client on a plain API key Service")
+	}
+
+	clientIDService := NewServiceWithClientID(http.DefaultClient, "clientID", "vNIXE0xscrmjlyV-12Nj_BvUPaw=")
+	query = make(url.Values)
+	clientIDService.addAuth(query)
+	if got := query.Get("client"); got != "clientID" {
+		t.Errorf("addAuth() client = %q, want %q", got, "clientID")
+	}
+	if query.Get("key") != "" {
+		t.Errorf("addAuth() unexpectedly set key on a client ID Service")
+	}
+}